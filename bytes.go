@@ -0,0 +1,87 @@
+// Binary data bridging helpers (`Uint8Array`/`ArrayBuffer`, `Blob`, `fetch`).
+
+// +build js,wasm
+
+package wasmhelper
+
+import (
+	"fmt"
+	"syscall/js"
+)
+
+// BytesToJS converts given bytes into a JS `Uint8Array`.
+func (h *WasmHelper) BytesToJS(b []byte) js.Value {
+	array := js.Global().Get("Uint8Array").New(len(b))
+	js.CopyBytesToJS(array, b)
+
+	return array
+}
+
+// JSToBytes converts given JS `Uint8Array`/`ArrayBuffer`/typed array view into bytes.
+func (h *WasmHelper) JSToBytes(v js.Value) ([]byte, error) {
+	if v.IsUndefined() || v.IsNull() {
+		return nil, fmt.Errorf("value is undefined or null")
+	}
+
+	if v.InstanceOf(js.Global().Get("ArrayBuffer")) {
+		view := js.Global().Get("Uint8Array").New(v)
+
+		bytes := make([]byte, view.Get("length").Int())
+		js.CopyBytesToGo(bytes, view)
+
+		return bytes, nil
+	}
+
+	if isTypedArray(v) {
+		return bytesFromTypedArray(v)
+	}
+
+	return nil, fmt.Errorf("value is neither a typed array nor an array buffer")
+}
+
+// NewBlob creates a new JS `Blob` out of given byte slice parts and MIME type.
+func (h *WasmHelper) NewBlob(parts [][]byte, mimeType string) js.Value {
+	jsParts := make([]interface{}, len(parts))
+	for i, part := range parts {
+		jsParts[i] = h.BytesToJS(part)
+	}
+
+	options := js.Global().Get("Object").New()
+	options.Set("type", mimeType)
+
+	return js.Global().Get("Blob").New(js.ValueOf(jsParts), options)
+}
+
+// Fetch performs a `fetch` request for given url and options, and returns its status,
+// headers and body, built on top of the Promise helpers.
+func (h *WasmHelper) Fetch(url string, opts map[string]interface{}) (status int, headers map[string]string, body []byte, err error) {
+	response, err := h.CallAsync("fetch", url, ToJS(opts))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	status = response.Get("status").Int()
+
+	headers = map[string]string{}
+	iterator := response.Get("headers").Call("entries")
+	for {
+		next := iterator.Call("next")
+		if next.Get("done").Bool() {
+			break
+		}
+
+		entry := next.Get("value")
+		headers[entry.Index(0).String()] = entry.Index(1).String()
+	}
+
+	arrayBuffer, err := h.Await(response.Call("arrayBuffer"))
+	if err != nil {
+		return status, headers, nil, err
+	}
+
+	if body, err = h.JSToBytes(arrayBuffer); err != nil {
+		return status, headers, nil, err
+	}
+
+	return status, headers, body, nil
+}
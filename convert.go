@@ -0,0 +1,335 @@
+// Reflection-based conversion helpers between Go values and `js.Value`s.
+
+// +build js,wasm
+
+package wasmhelper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"syscall/js"
+)
+
+// ToJS recursively converts given Go value into a `js.Value`.
+//
+// Structs are converted into JS objects (honoring `json:` tags for field names and
+// skipping unexported fields), maps with string keys into objects, slices/arrays into
+// JS arrays, and `func(...)` values into `js.FuncOf` wrappers. `js.Value`s and
+// `js.Func`s are passed through unchanged.
+func ToJS(v interface{}) js.Value {
+	if v == nil {
+		return js.Null()
+	}
+
+	switch converted := v.(type) {
+	case js.Value:
+		return converted
+	case js.Func:
+		return converted.Value
+	}
+
+	return toJSValue(reflect.ValueOf(v))
+}
+
+func toJSValue(rv reflect.Value) js.Value {
+	if rv.IsValid() && rv.CanInterface() {
+		switch converted := rv.Interface().(type) {
+		case js.Value:
+			return converted
+		case js.Func:
+			return converted.Value
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return js.Null()
+
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return js.Null()
+		}
+
+		return toJSValue(rv.Elem())
+
+	case reflect.Struct:
+		obj := js.Global().Get("Object").New()
+
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" { // unexported field
+				continue
+			}
+
+			name, skip := jsFieldName(field)
+			if skip {
+				continue
+			}
+
+			obj.Set(name, toJSValue(rv.Field(i)))
+		}
+
+		return obj
+
+	case reflect.Map:
+		obj := js.Global().Get("Object").New()
+
+		for _, key := range rv.MapKeys() {
+			obj.Set(fmt.Sprintf("%v", key.Interface()), toJSValue(rv.MapIndex(key)))
+		}
+
+		return obj
+
+	case reflect.Slice, reflect.Array:
+		length := rv.Len()
+		elements := make([]interface{}, length)
+		for i := 0; i < length; i++ {
+			elements[i] = toJSValue(rv.Index(i))
+		}
+
+		return js.ValueOf(elements)
+
+	case reflect.Func:
+		return funcToJS(rv)
+
+	default:
+		return js.ValueOf(rv.Interface())
+	}
+}
+
+// funcToJS wraps a Go function value as a `js.Func`, converting JS arguments back into
+// Go values with `FromJS`, and the Go return value (if any) back into JS with `ToJS`.
+func funcToJS(rv reflect.Value) js.Value {
+	t := rv.Type()
+
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		in := make([]reflect.Value, t.NumIn())
+
+		for i := 0; i < t.NumIn(); i++ {
+			paramPtr := reflect.New(t.In(i))
+
+			if i < len(args) {
+				if err := FromJS(args[i], paramPtr.Interface()); err != nil {
+					printLog("Error: could not convert argument #%d for function call: %s", i, err)
+				}
+			}
+
+			in[i] = paramPtr.Elem()
+		}
+
+		out := rv.Call(in)
+		if len(out) == 0 {
+			return nil
+		}
+
+		return ToJS(out[0].Interface())
+	})
+
+	return fn.Value
+}
+
+// FromJS populates `out` (which must be a non-nil pointer) from given `js.Value`,
+// converting nested structs/maps/slices along the way, and `Uint8Array`/`ArrayBuffer`
+// values into `[]byte`.
+func FromJS(v js.Value, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("out must be a non-nil pointer, but it was: %T", out)
+	}
+
+	return fromJSValue(v, rv.Elem())
+}
+
+func fromJSValue(v js.Value, rv reflect.Value) error {
+	switch v.Type() {
+	case js.TypeUndefined, js.TypeNull:
+		rv.Set(reflect.Zero(rv.Type()))
+
+		return nil
+
+	case js.TypeBoolean:
+		if rv.Kind() != reflect.Bool {
+			return fmt.Errorf("cannot convert JS boolean into %s", rv.Kind())
+		}
+
+		rv.SetBool(v.Bool())
+
+		return nil
+
+	case js.TypeNumber:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			rv.SetInt(int64(v.Float()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			rv.SetUint(uint64(v.Float()))
+		case reflect.Float32, reflect.Float64:
+			rv.SetFloat(v.Float())
+		default:
+			return fmt.Errorf("cannot convert JS number into %s", rv.Kind())
+		}
+
+		return nil
+
+	case js.TypeString:
+		if rv.Kind() != reflect.String {
+			return fmt.Errorf("cannot convert JS string into %s", rv.Kind())
+		}
+
+		rv.SetString(v.String())
+
+		return nil
+
+	case js.TypeObject:
+		return fromJSObject(v, rv)
+
+	default:
+		return fmt.Errorf("cannot convert JS value of type %s", v.Type())
+	}
+}
+
+func fromJSObject(v js.Value, rv reflect.Value) error {
+	if v.InstanceOf(js.Global().Get("ArrayBuffer")) {
+		v = js.Global().Get("Uint8Array").New(v)
+	}
+
+	if isTypedArray(v) {
+		bytes, err := bytesFromTypedArray(v)
+		if err != nil {
+			return err
+		}
+
+		if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("cannot convert JS typed array into %s", rv.Kind())
+		}
+
+		rv.SetBytes(bytes)
+
+		return nil
+	}
+
+	if v.InstanceOf(js.Global().Get("Array")) {
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return fmt.Errorf("cannot convert JS array into %s", rv.Kind())
+		}
+
+		length := v.Length()
+
+		var slice reflect.Value
+		if rv.Kind() == reflect.Slice {
+			slice = reflect.MakeSlice(rv.Type(), length, length)
+		} else {
+			slice = rv
+		}
+
+		for i := 0; i < length && i < slice.Len(); i++ {
+			if err := fromJSValue(v.Index(i), slice.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		if rv.Kind() == reflect.Slice {
+			rv.Set(slice)
+		}
+
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" { // unexported field
+				continue
+			}
+
+			name, skip := jsFieldName(field)
+			if skip {
+				continue
+			}
+
+			child := v.Get(name)
+			if child.Type() == js.TypeUndefined {
+				continue
+			}
+
+			if err := fromJSValue(child, rv.Field(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case reflect.Map:
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+
+		keys := js.Global().Get("Object").Call("keys", v)
+		for i := 0; i < keys.Length(); i++ {
+			key := keys.Index(i).String()
+
+			value := reflect.New(rv.Type().Elem()).Elem()
+			if err := fromJSValue(v.Get(key), value); err != nil {
+				return err
+			}
+
+			rv.SetMapIndex(reflect.ValueOf(key), value)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("cannot convert JS object into %s", rv.Kind())
+	}
+}
+
+// jsFieldName returns the JS property name for given struct field, honoring `json:` tags.
+func jsFieldName(field reflect.StructField) (name string, skip bool) {
+	name = field.Name
+
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return name, false
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] == "-" {
+		return name, true
+	}
+	if parts[0] != "" {
+		name = parts[0]
+	}
+
+	return name, false
+}
+
+// isTypedArray returns true if given value looks like a JS typed array (eg. `Uint8Array`).
+func isTypedArray(v js.Value) bool {
+	buffer := v.Get("buffer")
+
+	return !buffer.IsUndefined() && buffer.InstanceOf(js.Global().Get("ArrayBuffer"))
+}
+
+// bytesFromTypedArray copies bytes out of a JS typed array view.
+func bytesFromTypedArray(v js.Value) ([]byte, error) {
+	uint8Array := js.Global().Get("Uint8Array").New(v.Get("buffer"), v.Get("byteOffset"), v.Get("byteLength"))
+
+	bytes := make([]byte, uint8Array.Get("length").Int())
+	js.CopyBytesToGo(bytes, uint8Array)
+
+	return bytes, nil
+}
+
+// convertArgs converts given arguments into plain `interface{}`s consumable by `js.Value.Call`,
+// routing each one through `ToJS` so that callers can pass arbitrary Go values.
+func convertArgs(args []interface{}) []interface{} {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = ToJS(arg)
+	}
+
+	return converted
+}
@@ -0,0 +1,122 @@
+// Typed DOM/Event helpers built on top of `WasmHelper`.
+
+// +build js,wasm
+
+package wasmhelper
+
+import "syscall/js"
+
+// Element wraps a DOM element.
+type Element struct {
+	js.Value
+}
+
+// SetInnerHTML sets the innerHTML of this element.
+func (e Element) SetInnerHTML(html string) {
+	e.Set("innerHTML", html)
+}
+
+// SetAttribute sets an attribute of this element.
+func (e Element) SetAttribute(key, value string) {
+	e.Call("setAttribute", key, value)
+}
+
+// AddClass adds a class to this element's classList.
+func (e Element) AddClass(class string) {
+	e.Get("classList").Call("add", class)
+}
+
+// RemoveClass removes a class from this element's classList.
+func (e Element) RemoveClass(class string) {
+	e.Get("classList").Call("remove", class)
+}
+
+// Query returns the first descendant element matching given selector.
+func (e Element) Query(selector string) (Element, bool) {
+	found := e.Call("querySelector", selector)
+	if found.IsUndefined() || found.IsNull() {
+		return Element{}, false
+	}
+
+	return Element{found}, true
+}
+
+// QueryAll returns all descendant elements matching given selector.
+func (e Element) QueryAll(selector string) []Element {
+	found := e.Call("querySelectorAll", selector)
+
+	elements := make([]Element, found.Length())
+	for i := range elements {
+		elements[i] = Element{found.Index(i)}
+	}
+
+	return elements
+}
+
+// On registers given handler for given event, and returns a function that removes the
+// listener and releases its underlying `js.Func` when called.
+func (e Element) On(event string, handler func(Event)) (removeFn func()) {
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var value js.Value
+		if len(args) > 0 {
+			value = args[0]
+		}
+
+		handler(Event{value})
+
+		return nil
+	})
+
+	e.Call("addEventListener", event, fn)
+
+	return func() {
+		e.Call("removeEventListener", event, fn)
+		fn.Release()
+	}
+}
+
+// Event wraps a DOM event.
+type Event struct {
+	js.Value
+}
+
+// PreventDefault calls preventDefault() on this event.
+func (e Event) PreventDefault() {
+	e.Call("preventDefault")
+}
+
+// StopPropagation calls stopPropagation() on this event.
+func (e Event) StopPropagation() {
+	e.Call("stopPropagation")
+}
+
+// Target returns the target element of this event.
+func (e Event) Target() Element {
+	return Element{e.Get("target")}
+}
+
+// Get returns the value for given property name on the underlying event, as an escape
+// hatch for cases not covered by this type.
+func (e Event) Get(name string) js.Value {
+	return e.Value.Get(name)
+}
+
+// Document wraps the global `document` object.
+type Document struct {
+	js.Value
+}
+
+// GetElementByID returns the element with given id.
+func (d Document) GetElementByID(id string) (Element, bool) {
+	found := d.Call("getElementById", id)
+	if found.IsUndefined() || found.IsNull() {
+		return Element{}, false
+	}
+
+	return Element{found}, true
+}
+
+// Document returns a Document wrapping the global `document` object.
+func (h *WasmHelper) Document() Document {
+	return Document{js.Global().Get("document")}
+}
@@ -0,0 +1,95 @@
+// Promise helpers for bridging Go and JS asynchronous code.
+
+// +build js,wasm
+
+package wasmhelper
+
+import (
+	"fmt"
+	"syscall/js"
+)
+
+// NewPromise returns a new JS `Promise` which resolves or rejects according to `fn`.
+//
+// `fn` is run on a separate goroutine, and is expected to call `resolve` or `reject`
+// exactly once when it is done, letting Go code produce promises consumable by JS
+// (eg. returned from a registered callback).
+func (h *WasmHelper) NewPromise(fn func(resolve, reject func(interface{}))) js.Value {
+	executor := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		resolveFunc := args[0]
+		rejectFunc := args[1]
+
+		go fn(func(result interface{}) {
+			resolveFunc.Invoke(result)
+		}, func(reason interface{}) {
+			rejectFunc.Invoke(reason)
+		})
+
+		return nil
+	})
+
+	// `Promise`'s executor is called synchronously, so it is safe to release it right away
+	promise := js.Global().Get("Promise").New(executor)
+	executor.Release()
+
+	return promise
+}
+
+// Await blocks the calling goroutine until given promise settles, and returns its
+// resolved value, or a Go error built from the rejected value.
+//
+// This lets Go code consume JS promises (eg. from `fetch`, `crypto.subtle`, IndexedDB, ...)
+// without hand-rolling channel plumbing around `then`/`catch`.
+func (h *WasmHelper) Await(promise js.Value) (js.Value, error) {
+	done := make(chan struct{})
+
+	var result js.Value
+	var err error
+
+	then := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) > 0 {
+			result = args[0]
+		}
+
+		close(done)
+
+		return nil
+	})
+	defer then.Release()
+
+	catch := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) > 0 {
+			err = errorFromRejection(args[0])
+		} else {
+			err = fmt.Errorf("promise rejected with no reason")
+		}
+
+		close(done)
+
+		return nil
+	})
+	defer catch.Release()
+
+	promise.Call("then", then).Call("catch", catch)
+
+	<-done
+
+	return result, err
+}
+
+// CallAsync calls a function with given name and arguments, treats its return value
+// as a `Promise`, and awaits it.
+func (h *WasmHelper) CallAsync(name string, args ...interface{}) (js.Value, error) {
+	return h.Await(h.Call(name, args...))
+}
+
+// errorFromRejection builds a Go error from a JS promise's rejected value.
+func errorFromRejection(reason js.Value) error {
+	if reason.Type() == js.TypeObject {
+		if message := reason.Get("message"); message.Type() == js.TypeString {
+			return fmt.Errorf("%s", message.String())
+		}
+	}
+
+	return fmt.Errorf("%v", reason)
+}
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"syscall/js"
 
@@ -19,15 +20,16 @@ func main() {
 	helper := wh.New()
 	helper.SetVerbose(debug) // set verbosity,
 
+	document := helper.Document()
+
 	// show window.location,
 	var windowLocation = "unknown"
 	location := helper.Get("window.location")
 	if !location.IsUndefined() && !location.IsNull() {
 		windowLocation = location.String()
 
-		locationLabel := helper.Call("document.getElementById", "location")
-		if !locationLabel.IsUndefined() && !locationLabel.IsNull() {
-			helper.SetOn(locationLabel, "innerHTML", windowLocation)
+		if locationLabel, ok := document.GetElementByID("location"); ok {
+			locationLabel.SetInnerHTML(windowLocation)
 		}
 	}
 
@@ -38,12 +40,8 @@ func main() {
 			helper.Set("count", 0)
 
 			// and show it
-			count := helper.Get("count")
-			if !count.IsUndefined() && !count.IsNull() {
-				countLabel := helper.Call("document.getElementById", "counter")
-				if !countLabel.IsUndefined() && !countLabel.IsNull() {
-					helper.SetOn(countLabel, "innerHTML", count.Int())
-				}
+			if countLabel, ok := document.GetElementByID("counter"); ok {
+				countLabel.SetInnerHTML("0")
 			}
 
 			return nil
@@ -52,13 +50,12 @@ func main() {
 			// increase counter,
 			count := helper.Get("count")
 			if !count.IsUndefined() && !count.IsNull() {
-				count = js.ValueOf(count.Int() + 1)
-				helper.Set("count", count) // count ++
+				newCount := count.Int() + 1
+				helper.Set("count", newCount) // count ++
 
 				// and show it
-				countLabel := helper.Call("document.getElementById", "counter")
-				if !countLabel.IsUndefined() && !countLabel.IsNull() {
-					helper.SetOn(countLabel, "innerHTML", count.Int())
+				if countLabel, ok := document.GetElementByID("counter"); ok {
+					countLabel.SetInnerHTML(fmt.Sprintf("%d", newCount))
 				}
 			}
 
@@ -67,15 +64,12 @@ func main() {
 	})
 
 	// add event listeners,
-	button := helper.Call("document.getElementById", "button")
-	if !button.IsUndefined() && !button.IsNull() {
-		helper.CallOn(button, "addEventListener", "click", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+	if button, ok := document.GetElementByID("button"); ok {
+		button.On("click", func(event wh.Event) {
 			log.Printf("button clicked")
 
 			helper.Call("increaseCounter")
-
-			return nil
-		}))
+		})
 	}
 
 	// initialize,
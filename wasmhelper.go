@@ -18,10 +18,12 @@ import (
 type WasmHelper struct {
 	block   chan struct{}
 	verbose bool
+
+	funcs []js.Func
 }
 
 // WasmCallback function type
-type WasmCallback func(args []js.Value)
+type WasmCallback func(this js.Value, args []js.Value) interface{}
 
 // New returns a new WasmHelper struct
 func New() *WasmHelper {
@@ -43,8 +45,37 @@ func (h *WasmHelper) RegisterCallbacks(callbacks map[string]WasmCallback) {
 	}
 
 	for name, callback := range callbacks {
-		h.Set(name, js.NewCallback(callback))
+		h.RegisterCallback(name, callback)
+	}
+}
+
+// RegisterCallback registers a single callback function with given name, and returns
+// the underlying `js.Func` so that it can be `Release()`d individually, without waiting
+// for this helper's own `Release()`.
+func (h *WasmHelper) RegisterCallback(name string, cb WasmCallback) js.Func {
+	fn := js.FuncOf(cb)
+
+	h.Set(name, fn)
+	h.funcs = append(h.funcs, fn)
+
+	return fn
+}
+
+// Release releases all `js.Func`s created and tracked by this helper (eg. through
+// `RegisterCallback(s)`).
+//
+// `js.Func` handles persist for the lifetime of the WASM instance until released, so
+// this should be called when they are no longer needed to avoid leaking them.
+func (h *WasmHelper) Release() {
+	if h.verbose {
+		printLog("Releasing %d tracked function(s)...", len(h.funcs))
 	}
+
+	for _, fn := range h.funcs {
+		fn.Release()
+	}
+
+	h.funcs = nil
 }
 
 // Wait blocks until stopped manually, for long-running routines
@@ -66,12 +97,16 @@ func (h *WasmHelper) Wait() {
 	}
 }
 
-// Stop stops blocking
-func (h *WasmHelper) Stop() {
+// Stop stops blocking, optionally releasing all tracked `js.Func`s beforehand.
+func (h *WasmHelper) Stop(release bool) {
 	if h.verbose {
 		printLog("Stopping waiting...")
 	}
 
+	if release {
+		h.Release()
+	}
+
 	h.block <- struct{}{}
 }
 
@@ -168,7 +203,7 @@ func (h *WasmHelper) Set(name string, value interface{}) bool {
 	}
 
 	// set value
-	parent.Set(lastName, value)
+	parent.Set(lastName, ToJS(value))
 
 	return true
 }
@@ -186,7 +221,7 @@ func (h *WasmHelper) SetOn(obj js.Value, propertyName string, value interface{})
 		return false
 	}
 
-	obj.Set(propertyName, value)
+	obj.Set(propertyName, ToJS(value))
 
 	return true
 }
@@ -235,7 +270,7 @@ func (h *WasmHelper) Call(name string, args ...interface{}) js.Value {
 		printLog("Calling '%s' on %v with arguments: %s", funcName, parent, Prettify(args))
 	}
 
-	return parent.Call(funcName, args...)
+	return parent.Call(funcName, convertArgs(args)...)
 }
 
 // CallOn calls a function on a object with given name and arguments
@@ -270,7 +305,7 @@ func (h *WasmHelper) CallOn(obj js.Value, funcName string, args ...interface{})
 		printLog("Calling '%s' on %v with arguments: %s", funcName, obj, Prettify(args))
 	}
 
-	return obj.Call(funcName, args...)
+	return obj.Call(funcName, convertArgs(args)...)
 }
 
 // Invoke invokes given function with arguments
@@ -297,7 +332,7 @@ func (h *WasmHelper) Invoke(function js.Value, args ...interface{}) js.Value {
 		printLog("Invoking %v arguments: %s", function, Prettify(args))
 	}
 
-	return function.Invoke(args...)
+	return function.Invoke(convertArgs(args)...)
 }
 
 // print log to the console
@@ -306,6 +341,9 @@ func printLog(format string, v ...interface{}) {
 }
 
 // ToArray converts given value to array (returns nil on error)
+//
+// Typed arrays (eg. `Uint8Array`) are not genuine JS `Array`s, so they are rejected here;
+// use `JSToBytes` for those instead.
 func ToArray(value js.Value) []js.Value {
 	// undefined / null check
 	if value == js.Undefined() || value == js.Null() {
@@ -314,6 +352,13 @@ func ToArray(value js.Value) []js.Value {
 		return nil
 	}
 
+	// typed array short-circuit
+	if !value.InstanceOf(js.Global().Get("Array")) {
+		printLog("Error: given value is not a JS array")
+
+		return nil
+	}
+
 	array := make([]js.Value, value.Length())
 	for i := range array {
 		array[i] = value.Index(i)